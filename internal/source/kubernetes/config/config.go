@@ -0,0 +1,30 @@
+package config
+
+// UpdateSetting describes the object update event and the fields that should
+// be watched for changes.
+type UpdateSetting struct {
+	// Fields contains a list of JSONPaths that are compared between the old
+	// and new object to detect a relevant change.
+	Fields []string `yaml:"fields"`
+
+	// IncludeDiff indicates whether a human-readable diff of the changed
+	// fields should be attached to the rendered event.
+	IncludeDiff bool `yaml:"includeDiff"`
+
+	// AllFields, when set, ignores Fields and instead reports every path at
+	// which the old and new object differ. Useful for resources such as CRDs
+	// where enumerating every JSONPath up front isn't practical.
+	AllFields bool `yaml:"allFields"`
+
+	// IgnorePaths lists dot-separated paths (matching the format produced by
+	// ObjectReflectDiff) that should be excluded when AllFields is set, e.g.
+	// "metadata.resourceVersion" or "status.conditions[*].lastTransitionTime".
+	IgnorePaths []string `yaml:"ignorePaths"`
+
+	// Semantic, when set, suppresses diffs between values that are
+	// semantically equal but not textually identical, e.g. quantities
+	// ("1000m" vs "1"), RFC3339 timestamps that only differ in zone, or a
+	// reordered associative list. With AllFields set, lists are compared
+	// index by index, so a reordered list is still reported.
+	Semantic bool `yaml:"semantic"`
+}
@@ -0,0 +1,152 @@
+package k8sutil
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/util/jsonpath"
+
+	"github.com/kubeshop/botkube/internal/source/kubernetes/config"
+)
+
+// Diff provides a human-readable diff between the old and new object for the
+// JSONPaths configured in updateSetting.Fields. When updateSetting.AllFields
+// is set, every differing path between the two objects is reported instead,
+// see ObjectReflectDiff. When updateSetting.Semantic is set, values that are
+// semantically equal but not textually identical (e.g. "1000m" vs "1", or a
+// reordered list) are treated as unchanged, see semanticallyEqual. In
+// AllFields mode lists are compared index by index, so a reordered list is
+// still reported there.
+func Diff(x, y any, updateSetting config.UpdateSetting) (string, error) {
+	if updateSetting.AllFields {
+		fieldDiffs, err := ObjectReflectDiff(unwrapUnstructured(x), unwrapUnstructured(y), DiffOptions{IgnorePaths: updateSetting.IgnorePaths})
+		if err != nil {
+			return "", fmt.Errorf("while getting diff: %w", err)
+		}
+
+		if updateSetting.Semantic {
+			fieldDiffs = filterSemanticFieldDiffs(fieldDiffs)
+		}
+
+		return formatFieldDiffs(fieldDiffs), nil
+	}
+
+	var (
+		diff string
+		errs error
+	)
+	for _, field := range updateSetting.Fields {
+		oldVal, err := getFieldValue(x, field)
+		if err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("while finding value in old obj from jsonpath %q: %w", field, err))
+			continue
+		}
+
+		newVal, err := getFieldValue(y, field)
+		if err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("while finding value in new obj from jsonpath %q: %w", field, err))
+			continue
+		}
+
+		if oldVal == newVal {
+			continue
+		}
+
+		if updateSetting.Semantic && semanticallyEqual(oldVal, newVal) {
+			continue
+		}
+
+		diff += formatFieldDiff(field, oldVal, newVal)
+	}
+
+	if errs != nil {
+		return "", fmt.Errorf("while getting diff: %s", strings.TrimSpace(errs.Error()))
+	}
+
+	return diff, nil
+}
+
+// formatFieldDiff renders a single path/old/new triple using the same layout
+// regardless of which diff mode produced it, so Slack/Discord formatters stay
+// unaffected.
+func formatFieldDiff(path, oldVal, newVal string) string {
+	return fmt.Sprintf("%+v:\n\t-: %+v\n\t+: %+v\n", path, oldVal, newVal)
+}
+
+// formatFieldDiffs renders a slice of FieldDiff using formatFieldDiff.
+func formatFieldDiffs(diffs []FieldDiff) string {
+	var out string
+	for _, d := range diffs {
+		out += formatFieldDiff(d.Path, d.Old, d.New)
+	}
+	return out
+}
+
+// getFieldValue evaluates the JSONPath against obj and returns the rendered
+// value, or "<none>" if the path doesn't resolve. Unstructured objects and
+// raw maps are walked directly; any other type is round-tripped through JSON
+// first so plain Go structs keep working the way they always have.
+func getFieldValue(obj any, path string) (string, error) {
+	data, err := toJSONPathData(obj)
+	if err != nil {
+		return "", err
+	}
+
+	jp := jsonpath.New("")
+	jp.AllowMissingKeys(true)
+	if err := jp.Parse(fmt.Sprintf("{.%s}", path)); err != nil {
+		return "", err
+	}
+
+	var buf bytes.Buffer
+	if err := jp.Execute(&buf, data); err != nil {
+		return "", err
+	}
+
+	val := buf.String()
+	if val == "" {
+		return "<none>", nil
+	}
+	return val, nil
+}
+
+// toJSONPathData returns obj in a form jsonpath.Execute can walk. Unstructured
+// objects and raw maps are already in that form (a CRD arriving from a
+// dynamic informer never gets registered as a concrete Go type), so they're
+// used as-is; anything else is marshaled through JSON like before.
+func toJSONPathData(obj any) (any, error) {
+	switch v := unwrapUnstructured(obj).(type) {
+	case map[string]any:
+		return v, nil
+	}
+
+	raw, err := json.Marshal(obj)
+	if err != nil {
+		return nil, fmt.Errorf("while marshaling object: %w", err)
+	}
+
+	var data any
+	if err := json.Unmarshal(raw, &data); err != nil {
+		return nil, fmt.Errorf("while unmarshaling object: %w", err)
+	}
+	return data, nil
+}
+
+// unwrapUnstructured returns obj.Object when obj is an
+// unstructured.Unstructured, so a reflect-based walk descends straight into
+// the underlying map instead of the wrapper struct, which would otherwise
+// prefix every path with "object" instead of e.g. "spec". Anything else
+// (plain structs, raw maps) is returned unchanged.
+func unwrapUnstructured(obj any) any {
+	switch v := obj.(type) {
+	case *unstructured.Unstructured:
+		return v.Object
+	case unstructured.Unstructured:
+		return v.Object
+	}
+	return obj
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/MakeNowJust/heredoc"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
 	"github.com/kubeshop/botkube/internal/source/kubernetes/config"
 	"github.com/kubeshop/botkube/internal/source/kubernetes/k8sutil"
@@ -205,3 +206,307 @@ func (e *ExpectedDiff) MockDiff() string {
 	}
 	return fmt.Sprintf("%+v:\n\t-: %+v\n\t+: %+v\n", e.Path, e.X, e.Y)
 }
+
+func TestDiff_Unstructured(t *testing.T) {
+	tests := map[string]struct {
+		old      *unstructured.Unstructured
+		new      *unstructured.Unstructured
+		update   config.UpdateSetting
+		expected ExpectedDiff
+	}{
+		`Unstructured Deployment image changed`: {
+			old: &unstructured.Unstructured{Object: map[string]any{
+				"spec": map[string]any{
+					"template": map[string]any{
+						"spec": map[string]any{
+							"containers": []any{
+								map[string]any{"image": "nginx:1.14"},
+							},
+						},
+					},
+				},
+			}},
+			new: &unstructured.Unstructured{Object: map[string]any{
+				"spec": map[string]any{
+					"template": map[string]any{
+						"spec": map[string]any{
+							"containers": []any{
+								map[string]any{"image": "nginx:latest"},
+							},
+						},
+					},
+				},
+			}},
+			update: config.UpdateSetting{Fields: []string{"spec.template.spec.containers[*].image"}, IncludeDiff: true},
+			expected: ExpectedDiff{
+				Path: "spec.template.spec.containers[*].image",
+				X:    "nginx:1.14",
+				Y:    "nginx:latest",
+			},
+		},
+		`Unstructured Deployment replicas changed`: {
+			old:    &unstructured.Unstructured{Object: map[string]any{"spec": map[string]any{"replicas": int64(1)}}},
+			new:    &unstructured.Unstructured{Object: map[string]any{"spec": map[string]any{"replicas": int64(3)}}},
+			update: config.UpdateSetting{Fields: []string{"spec.replicas"}, IncludeDiff: true},
+			expected: ExpectedDiff{
+				Path: "spec.replicas",
+				X:    "1",
+				Y:    "3",
+			},
+		},
+		`Unstructured CRD spec field changed`: {
+			old:    &unstructured.Unstructured{Object: map[string]any{"spec": map[string]any{"version": "1.2.3"}}},
+			new:    &unstructured.Unstructured{Object: map[string]any{"spec": map[string]any{"version": "1.3.0"}}},
+			update: config.UpdateSetting{Fields: []string{"spec.version"}, IncludeDiff: true},
+			expected: ExpectedDiff{
+				Path: "spec.version",
+				X:    "1.2.3",
+				Y:    "1.3.0",
+			},
+		},
+	}
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			actual, err := k8sutil.Diff(test.old, test.new, test.update)
+
+			require.NoError(t, err)
+			assert.Equal(t, test.expected.MockDiff(), actual)
+		})
+	}
+}
+
+func TestDiff_Semantic(t *testing.T) {
+	type Resources struct {
+		CPU string `json:"cpu"`
+	}
+	type Requirements struct {
+		Requests Resources `json:"requests"`
+	}
+	type SemanticContainer struct {
+		Resources Requirements `json:"resources"`
+	}
+	type SemanticSpec struct {
+		Containers []SemanticContainer `json:"containers"`
+	}
+	type SemanticMeta struct {
+		Finalizers []string `json:"finalizers"`
+	}
+	type SemanticObject struct {
+		Spec     SemanticSpec `json:"spec"`
+		Metadata SemanticMeta `json:"metadata"`
+	}
+
+	tests := map[string]struct {
+		old      SemanticObject
+		new      SemanticObject
+		update   config.UpdateSetting
+		expected ExpectedDiff
+	}{
+		`Equivalent CPU quantities are not reported`: {
+			old:      SemanticObject{Spec: SemanticSpec{Containers: []SemanticContainer{{Resources: Requirements{Requests: Resources{CPU: "1000m"}}}}}},
+			new:      SemanticObject{Spec: SemanticSpec{Containers: []SemanticContainer{{Resources: Requirements{Requests: Resources{CPU: "1"}}}}}},
+			update:   config.UpdateSetting{Fields: []string{"spec.containers[*].resources.requests.cpu"}, IncludeDiff: true, Semantic: true},
+			expected: ExpectedDiff{},
+		},
+		`Differing CPU quantities are still reported`: {
+			old:    SemanticObject{Spec: SemanticSpec{Containers: []SemanticContainer{{Resources: Requirements{Requests: Resources{CPU: "500m"}}}}}},
+			new:    SemanticObject{Spec: SemanticSpec{Containers: []SemanticContainer{{Resources: Requirements{Requests: Resources{CPU: "1"}}}}}},
+			update: config.UpdateSetting{Fields: []string{"spec.containers[*].resources.requests.cpu"}, IncludeDiff: true, Semantic: true},
+			expected: ExpectedDiff{
+				Path: "spec.containers[*].resources.requests.cpu",
+				X:    "500m",
+				Y:    "1",
+			},
+		},
+		`Reordered finalizers are not reported`: {
+			old:      SemanticObject{Metadata: SemanticMeta{Finalizers: []string{"a", "b"}}},
+			new:      SemanticObject{Metadata: SemanticMeta{Finalizers: []string{"b", "a"}}},
+			update:   config.UpdateSetting{Fields: []string{"metadata.finalizers"}, IncludeDiff: true, Semantic: true},
+			expected: ExpectedDiff{},
+		},
+	}
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			actual, err := k8sutil.Diff(test.old, test.new, test.update)
+
+			require.NoError(t, err)
+			assert.Equal(t, test.expected.MockDiff(), actual)
+		})
+	}
+}
+
+func TestDiff_RawMap(t *testing.T) {
+	old := map[string]any{
+		"spec": map[string]any{
+			"nested": map[string]any{
+				"values": []any{"a", "b"},
+			},
+		},
+	}
+	new := map[string]any{
+		"spec": map[string]any{
+			"nested": map[string]any{
+				"values": []any{"a", "c"},
+			},
+		},
+	}
+	update := config.UpdateSetting{Fields: []string{"spec.nested.values"}, IncludeDiff: true}
+
+	actual, err := k8sutil.Diff(old, new, update)
+	require.NoError(t, err)
+
+	expected := ExpectedDiff{Path: "spec.nested.values", X: "[a b]", Y: "[a c]"}
+	assert.Equal(t, expected.MockDiff(), actual)
+}
+
+func TestObjectReflectDiff(t *testing.T) {
+	type nested struct {
+		Value string `json:"value"`
+	}
+	type obj struct {
+		Name   string            `json:"name"`
+		Labels map[string]string `json:"labels"`
+		Items  []nested          `json:"items"`
+	}
+
+	old := obj{Name: "a", Labels: map[string]string{"x": "1"}, Items: []nested{{Value: "one"}}}
+	new := obj{Name: "b", Labels: map[string]string{"x": "2", "y": "3"}, Items: []nested{{Value: "one"}, {Value: "two"}}}
+
+	diffs, err := k8sutil.ObjectReflectDiff(old, new, k8sutil.DiffOptions{})
+	require.NoError(t, err)
+
+	var paths []string
+	for _, d := range diffs {
+		paths = append(paths, d.Path)
+	}
+	assert.ElementsMatch(t, []string{`name`, `labels.x`, `labels.y`, `items[1]`}, paths)
+}
+
+func TestObjectReflectDiff_IgnorePaths(t *testing.T) {
+	type condition struct {
+		Type               string `json:"type"`
+		LastTransitionTime string `json:"lastTransitionTime"`
+	}
+	type status struct {
+		Conditions []condition `json:"conditions"`
+	}
+	type meta struct {
+		ResourceVersion string `json:"resourceVersion"`
+	}
+	type obj struct {
+		Metadata meta   `json:"metadata"`
+		Status   status `json:"status"`
+	}
+
+	old := obj{Metadata: meta{ResourceVersion: "1"}, Status: status{Conditions: []condition{{Type: "Ready", LastTransitionTime: "t0"}}}}
+	new := obj{Metadata: meta{ResourceVersion: "2"}, Status: status{Conditions: []condition{{Type: "NotReady", LastTransitionTime: "t1"}}}}
+
+	diffs, err := k8sutil.ObjectReflectDiff(old, new, k8sutil.DiffOptions{
+		IgnorePaths: []string{"metadata.resourceVersion", "status.conditions[*].lastTransitionTime"},
+	})
+	require.NoError(t, err)
+
+	require.Len(t, diffs, 1)
+	assert.Equal(t, "status.conditions[0].type", diffs[0].Path)
+}
+
+func TestDiff_AllFields(t *testing.T) {
+	type condition struct {
+		Type               string `json:"type"`
+		LastTransitionTime string `json:"lastTransitionTime"`
+	}
+	type status struct {
+		Conditions []condition `json:"conditions"`
+	}
+	type meta struct {
+		ResourceVersion string            `json:"resourceVersion"`
+		Annotations     map[string]string `json:"annotations"`
+	}
+	type spec struct {
+		Containers []Container `json:"containers"`
+	}
+	type object struct {
+		Metadata meta   `json:"metadata"`
+		Spec     spec   `json:"spec"`
+		Status   status `json:"status"`
+	}
+
+	old := object{
+		Metadata: meta{ResourceVersion: "111", Annotations: map[string]string{"foo": "bar"}},
+		Spec:     spec{Containers: []Container{{Image: "nginx:1.14"}}},
+		Status:   status{Conditions: []condition{{Type: "Ready", LastTransitionTime: "2023-01-01T00:00:00Z"}}},
+	}
+	new := object{
+		Metadata: meta{ResourceVersion: "112", Annotations: map[string]string{"foo": "boo"}},
+		Spec:     spec{Containers: []Container{{Image: "nginx:latest"}}},
+		Status:   status{Conditions: []condition{{Type: "Ready", LastTransitionTime: "2023-06-01T00:00:00Z"}}},
+	}
+
+	update := config.UpdateSetting{
+		AllFields:   true,
+		IncludeDiff: true,
+		IgnorePaths: []string{"metadata.resourceVersion", "status.conditions[*].lastTransitionTime"},
+	}
+
+	actual, err := k8sutil.Diff(old, new, update)
+	require.NoError(t, err)
+
+	expected := (&ExpectedDiff{Path: `metadata.annotations.foo`, X: "bar", Y: "boo"}).MockDiff() +
+		(&ExpectedDiff{Path: "spec.containers[0].image", X: "nginx:1.14", Y: "nginx:latest"}).MockDiff()
+	assert.Equal(t, expected, actual)
+}
+
+func TestDiff_AllFields_Unstructured(t *testing.T) {
+	old := &unstructured.Unstructured{Object: map[string]any{"spec": map[string]any{"replicas": int64(1)}}}
+	new := &unstructured.Unstructured{Object: map[string]any{"spec": map[string]any{"replicas": int64(3)}}}
+
+	update := config.UpdateSetting{AllFields: true, IncludeDiff: true}
+	actual, err := k8sutil.Diff(old, new, update)
+	require.NoError(t, err)
+
+	expected := (&ExpectedDiff{Path: `spec.replicas`, X: "1", Y: "3"}).MockDiff()
+	assert.Equal(t, expected, actual, "the reflect walk must descend into .Object, not the Unstructured wrapper, and map keys must use the same dotted notation as struct fields")
+}
+
+func TestDiff_AllFields_Unstructured_IgnorePaths(t *testing.T) {
+	old := &unstructured.Unstructured{Object: map[string]any{
+		"metadata": map[string]any{"resourceVersion": "111", "name": "a"},
+		"status": map[string]any{"conditions": []any{
+			map[string]any{"type": "Ready", "lastTransitionTime": "2023-01-01T00:00:00Z"},
+		}},
+	}}
+	new := &unstructured.Unstructured{Object: map[string]any{
+		"metadata": map[string]any{"resourceVersion": "112", "name": "b"},
+		"status": map[string]any{"conditions": []any{
+			map[string]any{"type": "Ready", "lastTransitionTime": "2023-06-01T00:00:00Z"},
+		}},
+	}}
+
+	update := config.UpdateSetting{
+		AllFields:   true,
+		IncludeDiff: true,
+		IgnorePaths: []string{"metadata.resourceVersion", "status.conditions[*].lastTransitionTime"},
+	}
+	actual, err := k8sutil.Diff(old, new, update)
+	require.NoError(t, err)
+
+	expected := (&ExpectedDiff{Path: `metadata.name`, X: "a", Y: "b"}).MockDiff()
+	assert.Equal(t, expected, actual, "IgnorePaths must match unstructured map keys the same way it matches struct fields")
+}
+
+func TestDiff_AllFields_SemanticNilVsEmpty(t *testing.T) {
+	old := map[string]any{"spec": map[string]any{}}
+	new := map[string]any{"spec": map[string]any{"items": []any{}}}
+
+	update := config.UpdateSetting{AllFields: true, IncludeDiff: true, Semantic: true}
+	actual, err := k8sutil.Diff(old, new, update)
+	require.NoError(t, err)
+	assert.Equal(t, "", actual, "a missing list and an explicitly empty one should be treated as unchanged")
+
+	update.Semantic = false
+	actual, err = k8sutil.Diff(old, new, update)
+	require.NoError(t, err)
+	assert.NotEqual(t, "", actual, "without Semantic the explicit empty list is still reported")
+}
@@ -0,0 +1,241 @@
+package k8sutil
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// FieldDiff describes a single path at which two objects differ.
+type FieldDiff struct {
+	Path string
+	Old  string
+	New  string
+}
+
+// DiffOptions configures ObjectReflectDiff.
+type DiffOptions struct {
+	// IgnorePaths lists paths (in the same dot/bracket notation produced by
+	// ObjectReflectDiff) that should never be reported, e.g.
+	// "metadata.resourceVersion" or "status.conditions[*].lastTransitionTime".
+	IgnorePaths []string
+}
+
+// ObjectReflectDiff walks old and new with reflect and returns every path at
+// which they differ. Unlike Diff, it doesn't require the caller to know the
+// JSONPaths up front, which makes it suitable for CRDs, dynamic informers, or
+// a generic "notify on any change" mode.
+func ObjectReflectDiff(old, new any, opts DiffOptions) ([]FieldDiff, error) {
+	ignore := make(map[string]struct{}, len(opts.IgnorePaths))
+	for _, p := range opts.IgnorePaths {
+		ignore[p] = struct{}{}
+	}
+
+	var diffs []FieldDiff
+	walkDiff("", reflect.ValueOf(old), reflect.ValueOf(new), ignore, &diffs)
+
+	sort.Slice(diffs, func(i, j int) bool { return diffs[i].Path < diffs[j].Path })
+	return diffs, nil
+}
+
+func walkDiff(path string, oldVal, newVal reflect.Value, ignore map[string]struct{}, diffs *[]FieldDiff) {
+	if isIgnored(path, ignore) {
+		return
+	}
+
+	oldVal, newVal = dereference(oldVal), dereference(newVal)
+
+	if !oldVal.IsValid() || !newVal.IsValid() {
+		if oldVal.IsValid() != newVal.IsValid() {
+			appendDiff(path, oldVal, newVal, diffs)
+		}
+		return
+	}
+
+	if oldVal.Type() != newVal.Type() {
+		appendDiff(path, oldVal, newVal, diffs)
+		return
+	}
+
+	switch oldVal.Kind() {
+	case reflect.Struct:
+		for i := 0; i < oldVal.NumField(); i++ {
+			field := oldVal.Type().Field(i)
+			if field.PkgPath != "" { // unexported
+				continue
+			}
+			walkDiff(joinPath(path, fieldName(field)), oldVal.Field(i), newVal.Field(i), ignore, diffs)
+		}
+	case reflect.Map:
+		walkMapDiff(path, oldVal, newVal, ignore, diffs)
+	case reflect.Slice, reflect.Array:
+		walkSliceDiff(path, oldVal, newVal, ignore, diffs)
+	default:
+		if !reflect.DeepEqual(oldVal.Interface(), newVal.Interface()) {
+			appendDiff(path, oldVal, newVal, diffs)
+		}
+	}
+}
+
+func walkMapDiff(path string, oldVal, newVal reflect.Value, ignore map[string]struct{}, diffs *[]FieldDiff) {
+	keys := make(map[string]reflect.Value)
+	for _, k := range oldVal.MapKeys() {
+		keys[fmt.Sprintf("%v", k.Interface())] = k
+	}
+	for _, k := range newVal.MapKeys() {
+		keys[fmt.Sprintf("%v", k.Interface())] = k
+	}
+
+	for keyStr, k := range keys {
+		walkDiff(joinPath(path, mapKeyName(keyStr)), oldVal.MapIndex(k), newVal.MapIndex(k), ignore, diffs)
+	}
+}
+
+// mapKeyName renders a map key the same way a struct field of that name
+// would be rendered, so IgnorePaths entries like "metadata.resourceVersion"
+// and ObjectReflectDiff's output agree whether the underlying object is a
+// typed struct or an unstructured.Unstructured/map[string]any. Keys holding a
+// "." or "/" (e.g. a well-known annotation key) are bracket-quoted instead,
+// since a dot would otherwise be read as a path separator.
+func mapKeyName(key string) string {
+	if strings.ContainsAny(key, "./") {
+		return fmt.Sprintf("[%q]", key)
+	}
+	return key
+}
+
+func walkSliceDiff(path string, oldVal, newVal reflect.Value, ignore map[string]struct{}, diffs *[]FieldDiff) {
+	max := oldVal.Len()
+	if newVal.Len() > max {
+		max = newVal.Len()
+	}
+
+	for i := 0; i < max; i++ {
+		childPath := fmt.Sprintf("%s[%d]", path, i)
+		var oldElem, newElem reflect.Value
+		if i < oldVal.Len() {
+			oldElem = oldVal.Index(i)
+		}
+		if i < newVal.Len() {
+			newElem = newVal.Index(i)
+		}
+		walkDiff(childPath, oldElem, newElem, ignore, diffs)
+	}
+}
+
+func appendDiff(path string, oldVal, newVal reflect.Value, diffs *[]FieldDiff) {
+	*diffs = append(*diffs, FieldDiff{
+		Path: path,
+		Old:  renderValue(oldVal),
+		New:  renderValue(newVal),
+	})
+}
+
+func renderValue(v reflect.Value) string {
+	if !v.IsValid() {
+		return "<none>"
+	}
+	return fmt.Sprintf("%+v", v.Interface())
+}
+
+func dereference(v reflect.Value) reflect.Value {
+	for v.IsValid() && (v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface) {
+		if v.IsNil() {
+			return reflect.Value{}
+		}
+		v = v.Elem()
+	}
+	return v
+}
+
+func fieldName(f reflect.StructField) string {
+	tag := f.Tag.Get("json")
+	name := strings.Split(tag, ",")[0]
+	if name == "" || name == "-" {
+		name = strings.ToLower(f.Name)
+	}
+	return name
+}
+
+func joinPath(base, field string) string {
+	switch {
+	case base == "":
+		return field
+	case strings.HasPrefix(field, "["):
+		return base + field
+	default:
+		return base + "." + field
+	}
+}
+
+func isIgnored(path string, ignore map[string]struct{}) bool {
+	if _, ok := ignore[path]; ok {
+		return true
+	}
+	for pattern := range ignore {
+		if matchesWildcard(pattern, path) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchesWildcard reports whether path matches pattern, where pattern may
+// contain a wildcard segment standing in for any one segment at that
+// position: "[*]" for a list index, e.g.
+// "status.conditions[*].lastTransitionTime", or "*" for a map key, e.g.
+// "metadata.annotations.*".
+func matchesWildcard(pattern, path string) bool {
+	patSegs := pathSegments(pattern)
+	pathSegs := pathSegments(path)
+	if len(patSegs) != len(pathSegs) {
+		return false
+	}
+	for i, seg := range patSegs {
+		if seg == "[*]" || seg == "*" {
+			continue
+		}
+		if seg != pathSegs[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// pathSegments splits a path produced by ObjectReflectDiff into its dotted
+// and bracketed segments, e.g. "status.conditions[0].lastTransitionTime"
+// becomes ["status", "conditions", "[0]", "lastTransitionTime"].
+func pathSegments(path string) []string {
+	var segs []string
+	var cur strings.Builder
+	flush := func() {
+		if cur.Len() > 0 {
+			segs = append(segs, cur.String())
+			cur.Reset()
+		}
+	}
+
+	for i := 0; i < len(path); {
+		switch path[i] {
+		case '.':
+			flush()
+			i++
+		case '[':
+			flush()
+			end := strings.IndexByte(path[i:], ']')
+			if end == -1 {
+				segs = append(segs, path[i:])
+				i = len(path)
+				continue
+			}
+			segs = append(segs, path[i:i+end+1])
+			i += end + 1
+		default:
+			cur.WriteByte(path[i])
+			i++
+		}
+	}
+	flush()
+	return segs
+}
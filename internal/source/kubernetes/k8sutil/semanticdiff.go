@@ -0,0 +1,90 @@
+package k8sutil
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+// semanticallyEqual reports whether oldVal and newVal, as rendered by
+// getFieldValue or FieldDiff, represent the same Kubernetes value even
+// though their textual form differs: equivalent resource.Quantity values
+// (e.g. "1000m" and "1"), RFC3339 timestamps that only differ in zone, a nil
+// vs. an explicitly empty list, and associative lists (e.g. a list of names)
+// whose items were merely reordered.
+func semanticallyEqual(oldVal, newVal string) bool {
+	if oldVal == newVal {
+		return true
+	}
+
+	if isEmptyList(oldVal) && isEmptyList(newVal) {
+		return true
+	}
+
+	if oldQty, err := resource.ParseQuantity(oldVal); err == nil {
+		if newQty, err := resource.ParseQuantity(newVal); err == nil {
+			return oldQty.Cmp(newQty) == 0
+		}
+	}
+
+	if oldTime, err := time.Parse(time.RFC3339, oldVal); err == nil {
+		if newTime, err := time.Parse(time.RFC3339, newVal); err == nil {
+			return oldTime.Equal(newTime)
+		}
+	}
+
+	return sameTokensIgnoringOrder(oldVal, newVal)
+}
+
+// filterSemanticFieldDiffs drops FieldDiff entries whose Old/New are
+// semantically equal, for use by the AllFields reflection-diff mode.
+func filterSemanticFieldDiffs(diffs []FieldDiff) []FieldDiff {
+	out := diffs[:0]
+	for _, d := range diffs {
+		if semanticallyEqual(d.Old, d.New) {
+			continue
+		}
+		out = append(out, d)
+	}
+	return out
+}
+
+// isEmptyList reports whether val is the rendering of a missing path or of
+// an explicitly empty list, so a nil slice/map and an empty one compare
+// equal.
+func isEmptyList(val string) bool {
+	return val == "<none>" || val == "[]"
+}
+
+// sameTokensIgnoringOrder reports whether oldVal and newVal are both
+// bracketed, space-separated lists (the form getFieldValue/renderValue
+// produce for a whole list-typed value, e.g. "[a b]") holding the same
+// elements, just in a different order. This catches e.g. a reordered
+// metadata.finalizers list.
+func sameTokensIgnoringOrder(oldVal, newVal string) bool {
+	oldTokens := listTokens(oldVal)
+	newTokens := listTokens(newVal)
+	if oldTokens == nil || newTokens == nil || len(oldTokens) != len(newTokens) {
+		return false
+	}
+
+	sort.Strings(oldTokens)
+	sort.Strings(newTokens)
+	for i := range oldTokens {
+		if oldTokens[i] != newTokens[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// listTokens returns the space-separated elements of a bracketed list
+// rendering like "[a b]", or nil if val isn't in that form.
+func listTokens(val string) []string {
+	if len(val) < 2 || val[0] != '[' || val[len(val)-1] != ']' {
+		return nil
+	}
+	return strings.Fields(val[1 : len(val)-1])
+}
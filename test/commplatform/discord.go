@@ -0,0 +1,296 @@
+package commplatform
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kubeshop/botkube/pkg/bot/interactive"
+)
+
+// DiscordConfig holds what's needed to drive a real Discord guild in
+// integration tests.
+type DiscordConfig struct {
+	BotToken           string
+	GuildID            string
+	TesterName         string
+	MessageWaitTimeout time.Duration
+}
+
+// discordChannel is a Channel backed by a real Discord channel.
+type discordChannel struct {
+	id   string
+	name string
+}
+
+func (c *discordChannel) ID() string         { return c.id }
+func (c *discordChannel) Name() string       { return c.name }
+func (c *discordChannel) Identifier() string { return c.id }
+
+var _ BotDriver = (*discordDriver)(nil)
+
+// discordDriver drives a real Discord guild over the REST API for channel
+// setup and over the gateway connection to capture posted messages.
+type discordDriver struct {
+	cfg     DiscordConfig
+	session *discordgo.Session
+
+	botUserID    string
+	testerUserID string
+
+	channel       Channel
+	secondChannel Channel
+	thirdChannel  Channel
+
+	mu       sync.Mutex
+	messages map[string][]*discordgo.Message // channelID -> messages, in arrival order
+}
+
+// NewDiscordDriver returns a driver for the Discord guild described by cfg,
+// ready to have InitUsers/InitChannels called on it.
+func NewDiscordDriver(cfg DiscordConfig) (*discordDriver, error) {
+	session, err := discordgo.New("Bot " + cfg.BotToken)
+	if err != nil {
+		return nil, fmt.Errorf("while creating discord session: %w", err)
+	}
+
+	return &discordDriver{
+		cfg:      cfg,
+		session:  session,
+		messages: make(map[string][]*discordgo.Message),
+	}, nil
+}
+
+// Type implements BotDriver.
+func (d *discordDriver) Type() DriverType { return DiscordBot }
+
+// InitUsers implements BotDriver, resolving the bot and tester user IDs and
+// opening the gateway connection used to capture posted messages.
+func (d *discordDriver) InitUsers(t *testing.T) {
+	d.session.AddHandler(func(_ *discordgo.Session, m *discordgo.MessageCreate) {
+		d.mu.Lock()
+		defer d.mu.Unlock()
+		d.messages[m.ChannelID] = append(d.messages[m.ChannelID], m.Message)
+	})
+
+	require.NoError(t, d.session.Open())
+
+	self, err := d.session.User("@me")
+	require.NoError(t, err)
+	d.botUserID = self.ID
+
+	members, err := d.session.GuildMembers(d.cfg.GuildID, "", 1000)
+	require.NoError(t, err)
+	for _, m := range members {
+		if m.User.Username == d.cfg.TesterName {
+			d.testerUserID = m.User.ID
+			break
+		}
+	}
+}
+
+// CreateChannel implements BotDriver.
+func (d *discordDriver) CreateChannel(t *testing.T, prefix string) (Channel, func(t *testing.T)) {
+	name := fmt.Sprintf("%s-%s-%d", channelNamePrefix, prefix, time.Now().UnixNano())
+	ch, err := d.session.GuildChannelCreate(d.cfg.GuildID, name, discordgo.ChannelTypeGuildText)
+	require.NoError(t, err)
+
+	cleanup := func(t *testing.T) {
+		_, err := d.session.ChannelDelete(ch.ID)
+		require.NoError(t, err)
+	}
+	return &discordChannel{id: ch.ID, name: ch.Name}, cleanup
+}
+
+// InitChannels implements BotDriver, provisioning the three channels shared
+// across a test run and returning their cleanup funcs.
+func (d *discordDriver) InitChannels(t *testing.T) []func() {
+	first, firstCleanup := d.CreateChannel(t, "first")
+	second, secondCleanup := d.CreateChannel(t, "second")
+	third, thirdCleanup := d.CreateChannel(t, "third")
+
+	d.channel = first
+	d.secondChannel = second
+	d.thirdChannel = third
+
+	return []func(){
+		func() { firstCleanup(t) },
+		func() { secondCleanup(t) },
+		func() { thirdCleanup(t) },
+	}
+}
+
+// PostInitialMessage implements BotDriver.
+func (d *discordDriver) PostInitialMessage(t *testing.T, channel string) {
+	_, err := d.session.ChannelMessageSend(channel, welcomeText)
+	require.NoError(t, err)
+}
+
+// PostMessageToBot implements BotDriver.
+func (d *discordDriver) PostMessageToBot(t *testing.T, channel, command string) {
+	_, err := d.session.ChannelMessageSend(channel, command)
+	require.NoError(t, err)
+}
+
+// InviteBotToChannel implements BotDriver. Discord channel membership
+// follows guild membership, so there's nothing to do per-channel.
+func (d *discordDriver) InviteBotToChannel(_ *testing.T, _ string) {}
+
+func (d *discordDriver) messagesFrom(userID, channelID string, limitMessages int) []*discordgo.Message {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	var out []*discordgo.Message
+	for _, m := range d.messages[channelID] {
+		if m.Author != nil && m.Author.ID == userID {
+			out = append(out, m)
+		}
+	}
+	if limitMessages > 0 && len(out) > limitMessages {
+		out = out[len(out)-limitMessages:]
+	}
+	return out
+}
+
+func (d *discordDriver) pollTimeout() time.Duration {
+	if d.cfg.MessageWaitTimeout > 0 {
+		return d.cfg.MessageWaitTimeout
+	}
+	return 30 * time.Second
+}
+
+func (d *discordDriver) waitFor(check func() (bool, error)) error {
+	deadline := time.Now().Add(d.pollTimeout())
+	for {
+		ok, err := check()
+		if err != nil {
+			return err
+		}
+		if ok {
+			return nil
+		}
+		if time.Now().After(deadline) {
+			return fmt.Errorf("timed out waiting for expected message")
+		}
+		time.Sleep(pollInterval)
+	}
+}
+
+// WaitForMessagePosted implements BotDriver.
+func (d *discordDriver) WaitForMessagePosted(userID, channel string, limitMessages int, assertFn MessageAssertion) error {
+	return d.waitFor(func() (bool, error) {
+		for _, m := range d.messagesFrom(userID, channel, limitMessages) {
+			if ok, _, _ := assertFn(m.Content); ok {
+				return true, nil
+			}
+		}
+		return false, nil
+	})
+}
+
+// WaitForMessagePostedRecentlyEqual implements BotDriver.
+func (d *discordDriver) WaitForMessagePostedRecentlyEqual(userID, channelID, expectedMsg string) error {
+	return d.WaitForMessagePosted(userID, channelID, 1, func(content string) (bool, int, string) {
+		return content == expectedMsg, 0, ""
+	})
+}
+
+// WaitForLastMessageContains implements BotDriver.
+func (d *discordDriver) WaitForLastMessageContains(userID, channel, expectedMsgSubstring string) error {
+	return d.waitFor(func() (bool, error) {
+		msgs := d.messagesFrom(userID, channel, 0)
+		if len(msgs) == 0 {
+			return false, nil
+		}
+		return strings.Contains(msgs[len(msgs)-1].Content, expectedMsgSubstring), nil
+	})
+}
+
+// WaitForLastMessageEqual implements BotDriver.
+func (d *discordDriver) WaitForLastMessageEqual(userID, channel, expectedMsg string) error {
+	return d.waitFor(func() (bool, error) {
+		msgs := d.messagesFrom(userID, channel, 0)
+		if len(msgs) == 0 {
+			return false, nil
+		}
+		return msgs[len(msgs)-1].Content == expectedMsg, nil
+	})
+}
+
+// WaitForMessagePostedInThread implements BotDriver, asserting against
+// messages in channelID that reply to (reference) the message rootTS, so
+// event-grouping follow-ups can be told apart from new top-level
+// notifications. Messages posted inside a separate Discord thread channel
+// are not searched.
+func (d *discordDriver) WaitForMessagePostedInThread(userID, channelID, rootTS string, assertFn MessageAssertion) error {
+	return d.waitFor(func() (bool, error) {
+		for _, m := range d.messagesFrom(userID, channelID, 0) {
+			if m.MessageReference == nil || m.MessageReference.MessageID != rootTS {
+				continue
+			}
+			if ok, _, _ := assertFn(m.Content); ok {
+				return true, nil
+			}
+		}
+		return false, nil
+	})
+}
+
+// WaitForInteractiveMessagePosted implements BotDriver.
+func (d *discordDriver) WaitForInteractiveMessagePosted(userID, channelID string, limitMessages int, assertFn MessageAssertion) error {
+	return d.WaitForMessagePosted(userID, channelID, limitMessages, assertFn)
+}
+
+// WaitForInteractiveMessagePostedRecentlyEqual implements BotDriver.
+func (d *discordDriver) WaitForInteractiveMessagePostedRecentlyEqual(userID string, channelID string, message interactive.CoreMessage) error {
+	return d.WaitForMessagePostedRecentlyEqual(userID, channelID, message.Description)
+}
+
+// WaitForLastInteractiveMessagePostedEqual implements BotDriver.
+func (d *discordDriver) WaitForLastInteractiveMessagePostedEqual(userID string, channelID string, message interactive.CoreMessage) error {
+	return d.WaitForLastMessageEqual(userID, channelID, message.Description)
+}
+
+// WaitForMessagePostedWithFileUpload implements BotDriver.
+func (d *discordDriver) WaitForMessagePostedWithFileUpload(userID, channelID string, assertFn FileUploadAssertion) error {
+	return d.waitFor(func() (bool, error) {
+		for _, m := range d.messagesFrom(userID, channelID, 0) {
+			for _, a := range m.Attachments {
+				if assertFn(a.Filename, a.ContentType) {
+					return true, nil
+				}
+			}
+		}
+		return false, nil
+	})
+}
+
+// WaitForMessagePostedWithAttachment implements BotDriver.
+func (d *discordDriver) WaitForMessagePostedWithAttachment(userID, channel string, limitMessages int, _ ExpAttachmentInput) error {
+	return d.WaitForMessagePosted(userID, channel, limitMessages, func(content string) (bool, int, string) {
+		return content != "", 0, ""
+	})
+}
+
+// Channel implements BotDriver.
+func (d *discordDriver) Channel() Channel { return d.channel }
+
+// SecondChannel implements BotDriver.
+func (d *discordDriver) SecondChannel() Channel { return d.secondChannel }
+
+// ThirdChannel implements BotDriver.
+func (d *discordDriver) ThirdChannel() Channel { return d.thirdChannel }
+
+// BotName implements BotDriver.
+func (d *discordDriver) BotName() string { return "botkube" }
+
+// BotUserID implements BotDriver.
+func (d *discordDriver) BotUserID() string { return d.botUserID }
+
+// TesterUserID implements BotDriver.
+func (d *discordDriver) TesterUserID() string { return d.testerUserID }
@@ -45,6 +45,11 @@ type BotDriver interface {
 	TesterUserID() string
 	WaitForInteractiveMessagePostedRecentlyEqual(userID string, channelID string, message interactive.CoreMessage) error
 	WaitForLastInteractiveMessagePostedEqual(userID string, channelID string, message interactive.CoreMessage) error
+	// WaitForMessagePostedInThread asserts that a reply to rootTS matches
+	// assertFn, rather than just any top-level message in channelID. This
+	// is what lets integration tests verify that event-grouping features
+	// land their follow-up notifications in the right thread.
+	WaitForMessagePostedInThread(userID, channelID, rootTS string, assertFn MessageAssertion) error
 }
 
 type MessageAssertion func(content string) (bool, int, string)
@@ -60,6 +65,7 @@ type ExpAttachmentInput struct {
 type DriverType string
 
 const (
-	SlackBot   DriverType = "slack"
-	DiscordBot DriverType = "discord"
-)
\ No newline at end of file
+	SlackBot      DriverType = "slack"
+	DiscordBot    DriverType = "discord"
+	MattermostBot DriverType = "mattermost"
+)
@@ -0,0 +1,349 @@
+package commplatform
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kubeshop/botkube/pkg/bot/interactive"
+)
+
+// MattermostConfig holds what's needed to drive a Mattermost server/team in
+// integration tests.
+type MattermostConfig struct {
+	URL                string
+	Token              string
+	TeamName           string
+	BotName            string
+	TesterName         string
+	MessageWaitTimeout time.Duration
+}
+
+// mattermostChannel is a Channel backed by a real Mattermost channel.
+type mattermostChannel struct {
+	id   string
+	name string
+}
+
+func (c *mattermostChannel) ID() string         { return c.id }
+func (c *mattermostChannel) Name() string       { return c.name }
+func (c *mattermostChannel) Identifier() string { return c.name }
+
+var _ BotDriver = (*mattermostDriver)(nil)
+
+// mattermostDriver drives a real Mattermost workspace over the REST API for
+// channel setup and over a WebSocket connection to capture posts, the same
+// way the Slack/Discord drivers capture events from their own APIs.
+type mattermostDriver struct {
+	cfg    MattermostConfig
+	client *model.Client4
+	ws     *model.WebSocketClient
+	teamID string
+
+	botUserID    string
+	testerUserID string
+
+	channel       Channel
+	secondChannel Channel
+	thirdChannel  Channel
+
+	mu    sync.Mutex
+	posts map[string][]*model.Post // channelID -> posts, in arrival order
+}
+
+// NewMattermostDriver connects to the Mattermost server described by cfg and
+// returns a driver ready to have InitUsers/InitChannels called on it.
+func NewMattermostDriver(cfg MattermostConfig) (*mattermostDriver, error) {
+	client := model.NewAPIv4Client(cfg.URL)
+	client.SetToken(cfg.Token)
+
+	team, _, err := client.GetTeamByName(cfg.TeamName, "")
+	if err != nil {
+		return nil, fmt.Errorf("while getting team %q: %w", cfg.TeamName, err)
+	}
+
+	return &mattermostDriver{
+		cfg:    cfg,
+		client: client,
+		teamID: team.Id,
+		posts:  make(map[string][]*model.Post),
+	}, nil
+}
+
+// Type implements BotDriver.
+func (d *mattermostDriver) Type() DriverType { return MattermostBot }
+
+// InitUsers implements BotDriver, resolving the bot and tester user IDs and
+// opening the WebSocket connection used to capture posted messages.
+func (d *mattermostDriver) InitUsers(t *testing.T) {
+	bot, _, err := d.client.GetUserByUsername(d.cfg.BotName, "")
+	require.NoError(t, err)
+	d.botUserID = bot.Id
+
+	tester, _, err := d.client.GetUserByUsername(d.cfg.TesterName, "")
+	require.NoError(t, err)
+	d.testerUserID = tester.Id
+
+	ws, err := model.NewWebSocketClient4(wsURL(d.cfg.URL), d.cfg.Token)
+	require.NoError(t, err)
+	d.ws = ws
+
+	ws.Listen()
+	go d.consumeEvents()
+}
+
+func (d *mattermostDriver) consumeEvents() {
+	for event := range d.ws.EventChannel {
+		if event.EventType() != model.WebsocketEventPosted {
+			continue
+		}
+		post, err := postFromEvent(event)
+		if err != nil {
+			continue
+		}
+		d.mu.Lock()
+		d.posts[post.ChannelId] = append(d.posts[post.ChannelId], post)
+		d.mu.Unlock()
+	}
+}
+
+// CreateChannel implements BotDriver.
+func (d *mattermostDriver) CreateChannel(t *testing.T, prefix string) (Channel, func(t *testing.T)) {
+	name := fmt.Sprintf("%s-%s-%d", channelNamePrefix, prefix, time.Now().UnixNano())
+	ch, _, err := d.client.CreateChannel(&model.Channel{
+		TeamId:      d.teamID,
+		Name:        name,
+		DisplayName: name,
+		Type:        model.ChannelTypeOpen,
+	})
+	require.NoError(t, err)
+
+	cleanup := func(t *testing.T) {
+		_, err := d.client.DeleteChannel(ch.Id)
+		require.NoError(t, err)
+	}
+	return &mattermostChannel{id: ch.Id, name: ch.Name}, cleanup
+}
+
+// InitChannels implements BotDriver, provisioning the three channels shared
+// across a test run and returning their cleanup funcs.
+func (d *mattermostDriver) InitChannels(t *testing.T) []func() {
+	first, firstCleanup := d.CreateChannel(t, "first")
+	second, secondCleanup := d.CreateChannel(t, "second")
+	third, thirdCleanup := d.CreateChannel(t, "third")
+
+	d.channel = first
+	d.secondChannel = second
+	d.thirdChannel = third
+
+	return []func(){
+		func() { firstCleanup(t) },
+		func() { secondCleanup(t) },
+		func() { thirdCleanup(t) },
+	}
+}
+
+// PostInitialMessage implements BotDriver.
+func (d *mattermostDriver) PostInitialMessage(t *testing.T, channel string) {
+	_, _, err := d.client.CreatePost(&model.Post{ChannelId: channel, Message: welcomeText})
+	require.NoError(t, err)
+}
+
+// PostMessageToBot implements BotDriver.
+func (d *mattermostDriver) PostMessageToBot(t *testing.T, channel, command string) {
+	_, _, err := d.client.CreatePost(&model.Post{ChannelId: channel, Message: command})
+	require.NoError(t, err)
+}
+
+// InviteBotToChannel implements BotDriver.
+func (d *mattermostDriver) InviteBotToChannel(t *testing.T, channel string) {
+	_, _, err := d.client.AddChannelMember(channel, d.botUserID)
+	require.NoError(t, err)
+}
+
+func (d *mattermostDriver) postsFrom(userID, channelID string, limitMessages int) []*model.Post {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	var out []*model.Post
+	for _, p := range d.posts[channelID] {
+		if p.UserId == userID {
+			out = append(out, p)
+		}
+	}
+	if limitMessages > 0 && len(out) > limitMessages {
+		out = out[len(out)-limitMessages:]
+	}
+	return out
+}
+
+func (d *mattermostDriver) pollTimeout() time.Duration {
+	if d.cfg.MessageWaitTimeout > 0 {
+		return d.cfg.MessageWaitTimeout
+	}
+	return 30 * time.Second
+}
+
+func (d *mattermostDriver) waitFor(check func() (bool, error)) error {
+	deadline := time.Now().Add(d.pollTimeout())
+	for {
+		ok, err := check()
+		if err != nil {
+			return err
+		}
+		if ok {
+			return nil
+		}
+		if time.Now().After(deadline) {
+			return fmt.Errorf("timed out waiting for expected message")
+		}
+		time.Sleep(pollInterval)
+	}
+}
+
+// WaitForMessagePosted implements BotDriver.
+func (d *mattermostDriver) WaitForMessagePosted(userID, channel string, limitMessages int, assertFn MessageAssertion) error {
+	return d.waitFor(func() (bool, error) {
+		for _, p := range d.postsFrom(userID, channel, limitMessages) {
+			if ok, _, _ := assertFn(p.Message); ok {
+				return true, nil
+			}
+		}
+		return false, nil
+	})
+}
+
+// WaitForMessagePostedRecentlyEqual implements BotDriver.
+func (d *mattermostDriver) WaitForMessagePostedRecentlyEqual(userID, channelID, expectedMsg string) error {
+	return d.WaitForMessagePosted(userID, channelID, 1, func(content string) (bool, int, string) {
+		return content == expectedMsg, 0, ""
+	})
+}
+
+// WaitForLastMessageContains implements BotDriver.
+func (d *mattermostDriver) WaitForLastMessageContains(userID, channel, expectedMsgSubstring string) error {
+	return d.waitFor(func() (bool, error) {
+		posts := d.postsFrom(userID, channel, 0)
+		if len(posts) == 0 {
+			return false, nil
+		}
+		last := posts[len(posts)-1]
+		return strings.Contains(last.Message, expectedMsgSubstring), nil
+	})
+}
+
+// WaitForLastMessageEqual implements BotDriver.
+func (d *mattermostDriver) WaitForLastMessageEqual(userID, channel, expectedMsg string) error {
+	return d.waitFor(func() (bool, error) {
+		posts := d.postsFrom(userID, channel, 0)
+		if len(posts) == 0 {
+			return false, nil
+		}
+		return posts[len(posts)-1].Message == expectedMsg, nil
+	})
+}
+
+// WaitForMessagePostedInThread implements BotDriver, asserting against
+// replies to rootTS, so event-grouping follow-ups can be told apart from new
+// top-level notifications.
+func (d *mattermostDriver) WaitForMessagePostedInThread(userID, channelID, rootTS string, assertFn MessageAssertion) error {
+	return d.waitFor(func() (bool, error) {
+		for _, p := range d.postsFrom(userID, channelID, 0) {
+			if p.RootId != rootTS {
+				continue
+			}
+			if ok, _, _ := assertFn(p.Message); ok {
+				return true, nil
+			}
+		}
+		return false, nil
+	})
+}
+
+// WaitForInteractiveMessagePosted implements BotDriver.
+func (d *mattermostDriver) WaitForInteractiveMessagePosted(userID, channelID string, limitMessages int, assertFn MessageAssertion) error {
+	return d.WaitForMessagePosted(userID, channelID, limitMessages, assertFn)
+}
+
+// WaitForInteractiveMessagePostedRecentlyEqual implements BotDriver.
+func (d *mattermostDriver) WaitForInteractiveMessagePostedRecentlyEqual(userID string, channelID string, message interactive.CoreMessage) error {
+	return d.WaitForMessagePostedRecentlyEqual(userID, channelID, message.Description)
+}
+
+// WaitForLastInteractiveMessagePostedEqual implements BotDriver.
+func (d *mattermostDriver) WaitForLastInteractiveMessagePostedEqual(userID string, channelID string, message interactive.CoreMessage) error {
+	return d.WaitForLastMessageEqual(userID, channelID, message.Description)
+}
+
+// WaitForMessagePostedWithFileUpload implements BotDriver.
+func (d *mattermostDriver) WaitForMessagePostedWithFileUpload(userID, channelID string, assertFn FileUploadAssertion) error {
+	return d.waitFor(func() (bool, error) {
+		for _, p := range d.postsFrom(userID, channelID, 0) {
+			for _, fileID := range p.FileIds {
+				info, _, err := d.client.GetFileInfo(fileID)
+				if err != nil {
+					continue
+				}
+				if assertFn(info.Name, info.MimeType) {
+					return true, nil
+				}
+			}
+		}
+		return false, nil
+	})
+}
+
+// WaitForMessagePostedWithAttachment implements BotDriver.
+func (d *mattermostDriver) WaitForMessagePostedWithAttachment(userID, channel string, limitMessages int, _ ExpAttachmentInput) error {
+	return d.WaitForMessagePosted(userID, channel, limitMessages, func(content string) (bool, int, string) {
+		return content != "", 0, ""
+	})
+}
+
+// Channel implements BotDriver.
+func (d *mattermostDriver) Channel() Channel { return d.channel }
+
+// SecondChannel implements BotDriver.
+func (d *mattermostDriver) SecondChannel() Channel { return d.secondChannel }
+
+// ThirdChannel implements BotDriver.
+func (d *mattermostDriver) ThirdChannel() Channel { return d.thirdChannel }
+
+// BotName implements BotDriver.
+func (d *mattermostDriver) BotName() string { return d.cfg.BotName }
+
+// BotUserID implements BotDriver.
+func (d *mattermostDriver) BotUserID() string { return d.botUserID }
+
+// TesterUserID implements BotDriver.
+func (d *mattermostDriver) TesterUserID() string { return d.testerUserID }
+
+func wsURL(httpURL string) string {
+	switch {
+	case strings.HasPrefix(httpURL, "https"):
+		return "wss" + httpURL[len("https"):]
+	case strings.HasPrefix(httpURL, "http"):
+		return "ws" + httpURL[len("http"):]
+	default:
+		return httpURL
+	}
+}
+
+func postFromEvent(event *model.WebSocketEvent) (*model.Post, error) {
+	postJSON, ok := event.GetData()["post"].(string)
+	if !ok {
+		return nil, fmt.Errorf("event has no post payload")
+	}
+
+	var post model.Post
+	if err := json.Unmarshal([]byte(postJSON), &post); err != nil {
+		return nil, fmt.Errorf("while unmarshaling post payload: %w", err)
+	}
+	return &post, nil
+}
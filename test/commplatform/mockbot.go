@@ -0,0 +1,343 @@
+package commplatform
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/kubeshop/botkube/pkg/bot/interactive"
+)
+
+// MockBotType identifies the in-memory MockBot driver.
+const MockBotType DriverType = "mock"
+
+var _ BotDriver = (*MockBot)(nil)
+
+// mockBotWaitTimeout bounds how long the Wait* methods poll the inbox before
+// giving up.
+const mockBotWaitTimeout = 5 * time.Second
+
+// Message is a single entry captured by MockBot: a plain text message, a
+// structured interactive.CoreMessage, or a file upload.
+type Message struct {
+	TS            string
+	ThreadRootTS  string
+	UserID        string
+	Text          string
+	Interactive   *interactive.CoreMessage
+	FileTitle     string
+	FileMimetype  string
+	HasFileUpload bool
+}
+
+// mockChannel is an in-memory Channel used by MockBot.
+type mockChannel struct {
+	id   string
+	name string
+}
+
+func (c *mockChannel) ID() string         { return c.id }
+func (c *mockChannel) Name() string       { return c.name }
+func (c *mockChannel) Identifier() string { return c.id }
+
+// MockBot is an in-process BotDriver for plugin unit tests. It satisfies the
+// full BotDriver interface without talking to a real Slack/Discord workspace,
+// so command-handling code can be driven from table-driven Go tests the same
+// way the synchronizer mock adapter enables its own unit suite.
+type MockBot struct {
+	mu         sync.Mutex
+	channelSeq int
+	msgSeq     int
+	inbox      map[string][]Message
+
+	botName      string
+	botUserID    string
+	testerUserID string
+
+	channel       Channel
+	secondChannel Channel
+	thirdChannel  Channel
+}
+
+// NewMockBot returns a ready-to-use MockBot with its default channels
+// already created.
+func NewMockBot() *MockBot {
+	b := &MockBot{
+		inbox:     make(map[string][]Message),
+		botName:   "mock-bot",
+		botUserID: "mock-bot-id",
+	}
+	b.channel = b.newChannel("default")
+	b.secondChannel = b.newChannel("second")
+	b.thirdChannel = b.newChannel("third")
+	return b
+}
+
+func (b *MockBot) newChannel(prefix string) Channel {
+	b.channelSeq++
+	id := fmt.Sprintf("%s-%s-%d", channelNamePrefix, prefix, b.channelSeq)
+	ch := &mockChannel{id: id, name: id}
+	b.inbox[ch.id] = nil
+	return ch
+}
+
+// Type implements BotDriver.
+func (b *MockBot) Type() DriverType { return MockBotType }
+
+// InitUsers implements BotDriver. MockBot has no real users to provision.
+func (b *MockBot) InitUsers(_ *testing.T) {}
+
+// SetTesterUserID sets the user ID that PostMessageToBot attributes its
+// messages to, so plugin tests can assert on a stable tester identity.
+func (b *MockBot) SetTesterUserID(userID string) { b.testerUserID = userID }
+
+// CreateChannel implements BotDriver, returning a fake channel with a
+// generated ID and a cleanup func that drops it and its inbox.
+func (b *MockBot) CreateChannel(_ *testing.T, prefix string) (Channel, func(t *testing.T)) {
+	b.mu.Lock()
+	ch := b.newChannel(prefix)
+	b.mu.Unlock()
+
+	cleanup := func(_ *testing.T) {
+		b.mu.Lock()
+		defer b.mu.Unlock()
+		delete(b.inbox, ch.ID())
+	}
+	return ch, cleanup
+}
+
+// InitChannels implements BotDriver. MockBot channels are ready immediately,
+// so there's nothing to schedule for cleanup here.
+func (b *MockBot) InitChannels(_ *testing.T) []func() { return nil }
+
+// PostInitialMessage implements BotDriver.
+func (b *MockBot) PostInitialMessage(_ *testing.T, channel string) {
+	b.pushMessage(channel, Message{UserID: b.botUserID, Text: welcomeText})
+}
+
+// PostMessageToBot implements BotDriver, pushing the command straight into
+// the channel's inbox as if the tester had typed it.
+func (b *MockBot) PostMessageToBot(_ *testing.T, channel, command string) {
+	b.pushMessage(channel, Message{UserID: b.testerUserID, Text: command})
+}
+
+// InviteBotToChannel implements BotDriver. There's no real membership to
+// manage in-process.
+func (b *MockBot) InviteBotToChannel(_ *testing.T, _ string) {}
+
+// PostMessage records text as if it had been posted by userID to channelID.
+// Plugin tests call this to simulate the bot's response without going
+// through a real Slack/Discord renderer.
+func (b *MockBot) PostMessage(channelID, userID, text string) {
+	b.pushMessage(channelID, Message{UserID: userID, Text: text})
+}
+
+// PostInteractiveMessage records msg as if it had been posted by userID to
+// channelID, capturing it structurally so assertions can compare
+// buttons/sections without going through Slack's block-kit renderer.
+func (b *MockBot) PostInteractiveMessage(channelID, userID string, msg interactive.CoreMessage) {
+	b.pushMessage(channelID, Message{UserID: userID, Interactive: &msg})
+}
+
+// PostFileUpload records a file upload as if it had been posted by userID to
+// channelID.
+func (b *MockBot) PostFileUpload(channelID, userID, title, mimetype string) {
+	b.pushMessage(channelID, Message{UserID: userID, FileTitle: title, FileMimetype: mimetype, HasFileUpload: true})
+}
+
+// PostThreadReply records text as if it had been posted by userID to
+// channelID in reply to rootTS, for WaitForMessagePostedInThread to find.
+func (b *MockBot) PostThreadReply(channelID, userID, rootTS, text string) {
+	b.pushMessage(channelID, Message{UserID: userID, Text: text, ThreadRootTS: rootTS})
+}
+
+func (b *MockBot) pushMessage(channelID string, msg Message) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.msgSeq++
+	msg.TS = fmt.Sprintf("%d", b.msgSeq)
+	b.inbox[channelID] = append(b.inbox[channelID], msg)
+}
+
+// Inbox returns every message posted to channelID so far, in post order.
+func (b *MockBot) Inbox(channelID string) []Message {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	out := make([]Message, len(b.inbox[channelID]))
+	copy(out, b.inbox[channelID])
+	return out
+}
+
+func (b *MockBot) messagesFrom(userID, channelID string) []Message {
+	var out []Message
+	for _, m := range b.Inbox(channelID) {
+		if m.UserID == userID {
+			out = append(out, m)
+		}
+	}
+	return out
+}
+
+// poll re-evaluates check every pollInterval until it returns true or
+// mockBotWaitTimeout elapses.
+func poll(check func() (bool, error)) error {
+	deadline := time.Now().Add(mockBotWaitTimeout)
+	for {
+		ok, err := check()
+		if err != nil {
+			return err
+		}
+		if ok {
+			return nil
+		}
+		if time.Now().After(deadline) {
+			return fmt.Errorf("timed out waiting for expected message")
+		}
+		time.Sleep(pollInterval)
+	}
+}
+
+// WaitForMessagePosted implements BotDriver.
+func (b *MockBot) WaitForMessagePosted(userID, channel string, limitMessages int, assertFn MessageAssertion) error {
+	return poll(func() (bool, error) {
+		msgs := b.messagesFrom(userID, channel)
+		if len(msgs) > limitMessages {
+			msgs = msgs[len(msgs)-limitMessages:]
+		}
+		for _, m := range msgs {
+			if ok, _, _ := assertFn(m.Text); ok {
+				return true, nil
+			}
+		}
+		return false, nil
+	})
+}
+
+// WaitForMessagePostedRecentlyEqual implements BotDriver.
+func (b *MockBot) WaitForMessagePostedRecentlyEqual(userID, channelID, expectedMsg string) error {
+	return b.WaitForMessagePosted(userID, channelID, 1, func(content string) (bool, int, string) {
+		return content == expectedMsg, 0, ""
+	})
+}
+
+// WaitForLastMessageContains implements BotDriver.
+func (b *MockBot) WaitForLastMessageContains(userID, channel, expectedMsgSubstring string) error {
+	return poll(func() (bool, error) {
+		msgs := b.messagesFrom(userID, channel)
+		if len(msgs) == 0 {
+			return false, nil
+		}
+		last := msgs[len(msgs)-1]
+		return strings.Contains(last.Text, expectedMsgSubstring), nil
+	})
+}
+
+// WaitForLastMessageEqual implements BotDriver.
+func (b *MockBot) WaitForLastMessageEqual(userID, channel, expectedMsg string) error {
+	return poll(func() (bool, error) {
+		msgs := b.messagesFrom(userID, channel)
+		if len(msgs) == 0 {
+			return false, nil
+		}
+		return msgs[len(msgs)-1].Text == expectedMsg, nil
+	})
+}
+
+// WaitForMessagePostedInThread implements BotDriver, asserting against
+// replies recorded against rootTS rather than any top-level message.
+func (b *MockBot) WaitForMessagePostedInThread(userID, channelID, rootTS string, assertFn MessageAssertion) error {
+	return poll(func() (bool, error) {
+		for _, m := range b.messagesFrom(userID, channelID) {
+			if m.ThreadRootTS != rootTS {
+				continue
+			}
+			if ok, _, _ := assertFn(m.Text); ok {
+				return true, nil
+			}
+		}
+		return false, nil
+	})
+}
+
+// WaitForInteractiveMessagePosted implements BotDriver.
+func (b *MockBot) WaitForInteractiveMessagePosted(userID, channelID string, limitMessages int, assertFn MessageAssertion) error {
+	return poll(func() (bool, error) {
+		msgs := b.messagesFrom(userID, channelID)
+		if len(msgs) > limitMessages {
+			msgs = msgs[len(msgs)-limitMessages:]
+		}
+		for _, m := range msgs {
+			if m.Interactive == nil {
+				continue
+			}
+			if ok, _, _ := assertFn(fmt.Sprintf("%+v", *m.Interactive)); ok {
+				return true, nil
+			}
+		}
+		return false, nil
+	})
+}
+
+// WaitForInteractiveMessagePostedRecentlyEqual implements BotDriver.
+func (b *MockBot) WaitForInteractiveMessagePostedRecentlyEqual(userID string, channelID string, message interactive.CoreMessage) error {
+	return poll(func() (bool, error) {
+		msgs := b.messagesFrom(userID, channelID)
+		for _, m := range msgs {
+			if m.Interactive != nil && reflect.DeepEqual(*m.Interactive, message) {
+				return true, nil
+			}
+		}
+		return false, nil
+	})
+}
+
+// WaitForLastInteractiveMessagePostedEqual implements BotDriver.
+func (b *MockBot) WaitForLastInteractiveMessagePostedEqual(userID string, channelID string, message interactive.CoreMessage) error {
+	return poll(func() (bool, error) {
+		msgs := b.messagesFrom(userID, channelID)
+		if len(msgs) == 0 || msgs[len(msgs)-1].Interactive == nil {
+			return false, nil
+		}
+		return reflect.DeepEqual(*msgs[len(msgs)-1].Interactive, message), nil
+	})
+}
+
+// WaitForMessagePostedWithFileUpload implements BotDriver.
+func (b *MockBot) WaitForMessagePostedWithFileUpload(userID, channelID string, assertFn FileUploadAssertion) error {
+	return poll(func() (bool, error) {
+		for _, m := range b.messagesFrom(userID, channelID) {
+			if m.HasFileUpload && assertFn(m.FileTitle, m.FileMimetype) {
+				return true, nil
+			}
+		}
+		return false, nil
+	})
+}
+
+// WaitForMessagePostedWithAttachment implements BotDriver.
+func (b *MockBot) WaitForMessagePostedWithAttachment(userID, channel string, limitMessages int, _ ExpAttachmentInput) error {
+	return b.WaitForMessagePosted(userID, channel, limitMessages, func(content string) (bool, int, string) {
+		return content != "", 0, ""
+	})
+}
+
+// Channel implements BotDriver.
+func (b *MockBot) Channel() Channel { return b.channel }
+
+// SecondChannel implements BotDriver.
+func (b *MockBot) SecondChannel() Channel { return b.secondChannel }
+
+// ThirdChannel implements BotDriver.
+func (b *MockBot) ThirdChannel() Channel { return b.thirdChannel }
+
+// BotName implements BotDriver.
+func (b *MockBot) BotName() string { return b.botName }
+
+// BotUserID implements BotDriver.
+func (b *MockBot) BotUserID() string { return b.botUserID }
+
+// TesterUserID implements BotDriver.
+func (b *MockBot) TesterUserID() string { return b.testerUserID }
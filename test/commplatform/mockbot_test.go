@@ -0,0 +1,59 @@
+package commplatform
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kubeshop/botkube/pkg/bot/interactive"
+)
+
+func TestMockBot_WaitForMessagePosted(t *testing.T) {
+	bot := NewMockBot()
+	bot.SetTesterUserID("tester")
+
+	channel := bot.Channel()
+	bot.PostMessage(channel.ID(), bot.BotUserID(), "pong")
+
+	err := bot.WaitForMessagePosted(bot.BotUserID(), channel.ID(), 1, func(content string) (bool, int, string) {
+		return content == "pong", 0, ""
+	})
+	require.NoError(t, err)
+
+	assert.Len(t, bot.Inbox(channel.ID()), 1)
+}
+
+func TestMockBot_WaitForLastMessageEqual_Timeout(t *testing.T) {
+	bot := NewMockBot()
+	channel := bot.Channel()
+
+	err := bot.WaitForLastMessageEqual(bot.BotUserID(), channel.ID(), "never posted")
+	assert.Error(t, err)
+}
+
+func TestMockBot_WaitForMessagePostedInThread(t *testing.T) {
+	bot := NewMockBot()
+	channel := bot.Channel()
+
+	bot.PostMessage(channel.ID(), bot.BotUserID(), "root notification")
+	rootTS := bot.Inbox(channel.ID())[0].TS
+	bot.PostMessage(channel.ID(), bot.BotUserID(), "unrelated top-level message")
+	bot.PostThreadReply(channel.ID(), bot.BotUserID(), rootTS, "grouped follow-up")
+
+	err := bot.WaitForMessagePostedInThread(bot.BotUserID(), channel.ID(), rootTS, func(content string) (bool, int, string) {
+		return content == "grouped follow-up", 0, ""
+	})
+	require.NoError(t, err)
+}
+
+func TestMockBot_InteractiveMessageCapturedStructurally(t *testing.T) {
+	bot := NewMockBot()
+	channel := bot.Channel()
+
+	msg := interactive.CoreMessage{}
+	bot.PostInteractiveMessage(channel.ID(), bot.BotUserID(), msg)
+
+	err := bot.WaitForLastInteractiveMessagePostedEqual(bot.BotUserID(), channel.ID(), msg)
+	require.NoError(t, err)
+}
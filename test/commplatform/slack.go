@@ -0,0 +1,303 @@
+package commplatform
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/slack-go/slack"
+	"github.com/slack-go/slack/socketmode"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kubeshop/botkube/pkg/bot/interactive"
+)
+
+// SlackConfig holds what's needed to drive a real Slack workspace in
+// integration tests.
+type SlackConfig struct {
+	AppToken           string
+	BotToken           string
+	TesterName         string
+	MessageWaitTimeout time.Duration
+}
+
+// slackChannel is a Channel backed by a real Slack channel.
+type slackChannel struct {
+	id   string
+	name string
+}
+
+func (c *slackChannel) ID() string         { return c.id }
+func (c *slackChannel) Name() string       { return c.name }
+func (c *slackChannel) Identifier() string { return c.id }
+
+var _ BotDriver = (*slackDriver)(nil)
+
+// slackDriver drives a real Slack workspace over the Web API for channel
+// setup and over Socket Mode to capture posted messages.
+type slackDriver struct {
+	cfg    SlackConfig
+	client *slack.Client
+	socket *socketmode.Client
+
+	botUserID    string
+	testerUserID string
+
+	channel       Channel
+	secondChannel Channel
+	thirdChannel  Channel
+
+	mu       sync.Mutex
+	messages map[string][]*slack.MessageEvent // channelID -> messages, in arrival order
+}
+
+// NewSlackDriver returns a driver for the Slack workspace described by cfg,
+// ready to have InitUsers/InitChannels called on it.
+func NewSlackDriver(cfg SlackConfig) *slackDriver {
+	client := slack.New(cfg.BotToken, slack.OptionAppLevelToken(cfg.AppToken))
+	return &slackDriver{
+		cfg:      cfg,
+		client:   client,
+		socket:   socketmode.New(client),
+		messages: make(map[string][]*slack.MessageEvent),
+	}
+}
+
+// Type implements BotDriver.
+func (d *slackDriver) Type() DriverType { return SlackBot }
+
+// InitUsers implements BotDriver, resolving the bot and tester user IDs and
+// starting the Socket Mode connection used to capture posted messages.
+func (d *slackDriver) InitUsers(t *testing.T) {
+	authResp, err := d.client.AuthTest()
+	require.NoError(t, err)
+	d.botUserID = authResp.UserID
+
+	tester, err := d.client.GetUserByEmail(d.cfg.TesterName)
+	require.NoError(t, err)
+	d.testerUserID = tester.ID
+
+	go d.consumeEvents()
+	go func() {
+		_ = d.socket.Run()
+	}()
+}
+
+func (d *slackDriver) consumeEvents() {
+	for evt := range d.socket.Events {
+		eventsAPI, ok := evt.Data.(slack.EventsAPIEvent)
+		if !ok {
+			continue
+		}
+		inner, ok := eventsAPI.InnerEvent.Data.(*slack.MessageEvent)
+		if !ok {
+			continue
+		}
+		d.mu.Lock()
+		d.messages[inner.Channel] = append(d.messages[inner.Channel], inner)
+		d.mu.Unlock()
+	}
+}
+
+// CreateChannel implements BotDriver.
+func (d *slackDriver) CreateChannel(t *testing.T, prefix string) (Channel, func(t *testing.T)) {
+	name := fmt.Sprintf("%s-%s-%d", channelNamePrefix, prefix, time.Now().UnixNano())
+	ch, err := d.client.CreateConversation(slack.CreateConversationParams{ChannelName: name})
+	require.NoError(t, err)
+
+	cleanup := func(t *testing.T) {
+		_, err := d.client.ArchiveConversation(ch.ID)
+		require.NoError(t, err)
+	}
+	return &slackChannel{id: ch.ID, name: ch.Name}, cleanup
+}
+
+// InitChannels implements BotDriver, provisioning the three channels shared
+// across a test run and returning their cleanup funcs.
+func (d *slackDriver) InitChannels(t *testing.T) []func() {
+	first, firstCleanup := d.CreateChannel(t, "first")
+	second, secondCleanup := d.CreateChannel(t, "second")
+	third, thirdCleanup := d.CreateChannel(t, "third")
+
+	d.channel = first
+	d.secondChannel = second
+	d.thirdChannel = third
+
+	return []func(){
+		func() { firstCleanup(t) },
+		func() { secondCleanup(t) },
+		func() { thirdCleanup(t) },
+	}
+}
+
+// PostInitialMessage implements BotDriver.
+func (d *slackDriver) PostInitialMessage(t *testing.T, channel string) {
+	_, _, err := d.client.PostMessage(channel, slack.MsgOptionText(welcomeText, false))
+	require.NoError(t, err)
+}
+
+// PostMessageToBot implements BotDriver.
+func (d *slackDriver) PostMessageToBot(t *testing.T, channel, command string) {
+	_, _, err := d.client.PostMessage(channel, slack.MsgOptionText(command, false))
+	require.NoError(t, err)
+}
+
+// InviteBotToChannel implements BotDriver.
+func (d *slackDriver) InviteBotToChannel(t *testing.T, channel string) {
+	_, err := d.client.InviteUsersToConversation(channel, d.botUserID)
+	require.NoError(t, err)
+}
+
+func (d *slackDriver) messagesFrom(userID, channelID string, limitMessages int) []*slack.MessageEvent {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	var out []*slack.MessageEvent
+	for _, m := range d.messages[channelID] {
+		if m.User == userID {
+			out = append(out, m)
+		}
+	}
+	if limitMessages > 0 && len(out) > limitMessages {
+		out = out[len(out)-limitMessages:]
+	}
+	return out
+}
+
+func (d *slackDriver) pollTimeout() time.Duration {
+	if d.cfg.MessageWaitTimeout > 0 {
+		return d.cfg.MessageWaitTimeout
+	}
+	return 30 * time.Second
+}
+
+func (d *slackDriver) waitFor(check func() (bool, error)) error {
+	deadline := time.Now().Add(d.pollTimeout())
+	for {
+		ok, err := check()
+		if err != nil {
+			return err
+		}
+		if ok {
+			return nil
+		}
+		if time.Now().After(deadline) {
+			return fmt.Errorf("timed out waiting for expected message")
+		}
+		time.Sleep(pollInterval)
+	}
+}
+
+// WaitForMessagePosted implements BotDriver.
+func (d *slackDriver) WaitForMessagePosted(userID, channel string, limitMessages int, assertFn MessageAssertion) error {
+	return d.waitFor(func() (bool, error) {
+		for _, m := range d.messagesFrom(userID, channel, limitMessages) {
+			if ok, _, _ := assertFn(m.Text); ok {
+				return true, nil
+			}
+		}
+		return false, nil
+	})
+}
+
+// WaitForMessagePostedRecentlyEqual implements BotDriver.
+func (d *slackDriver) WaitForMessagePostedRecentlyEqual(userID, channelID, expectedMsg string) error {
+	return d.WaitForMessagePosted(userID, channelID, 1, func(content string) (bool, int, string) {
+		return content == expectedMsg, 0, ""
+	})
+}
+
+// WaitForLastMessageContains implements BotDriver.
+func (d *slackDriver) WaitForLastMessageContains(userID, channel, expectedMsgSubstring string) error {
+	return d.waitFor(func() (bool, error) {
+		msgs := d.messagesFrom(userID, channel, 0)
+		if len(msgs) == 0 {
+			return false, nil
+		}
+		return strings.Contains(msgs[len(msgs)-1].Text, expectedMsgSubstring), nil
+	})
+}
+
+// WaitForLastMessageEqual implements BotDriver.
+func (d *slackDriver) WaitForLastMessageEqual(userID, channel, expectedMsg string) error {
+	return d.waitFor(func() (bool, error) {
+		msgs := d.messagesFrom(userID, channel, 0)
+		if len(msgs) == 0 {
+			return false, nil
+		}
+		return msgs[len(msgs)-1].Text == expectedMsg, nil
+	})
+}
+
+// WaitForMessagePostedInThread implements BotDriver, asserting against
+// replies to rootTS, so event-grouping follow-ups can be told apart from new
+// top-level notifications.
+func (d *slackDriver) WaitForMessagePostedInThread(userID, channelID, rootTS string, assertFn MessageAssertion) error {
+	return d.waitFor(func() (bool, error) {
+		for _, m := range d.messagesFrom(userID, channelID, 0) {
+			if m.ThreadTimestamp != rootTS {
+				continue
+			}
+			if ok, _, _ := assertFn(m.Text); ok {
+				return true, nil
+			}
+		}
+		return false, nil
+	})
+}
+
+// WaitForInteractiveMessagePosted implements BotDriver.
+func (d *slackDriver) WaitForInteractiveMessagePosted(userID, channelID string, limitMessages int, assertFn MessageAssertion) error {
+	return d.WaitForMessagePosted(userID, channelID, limitMessages, assertFn)
+}
+
+// WaitForInteractiveMessagePostedRecentlyEqual implements BotDriver.
+func (d *slackDriver) WaitForInteractiveMessagePostedRecentlyEqual(userID string, channelID string, message interactive.CoreMessage) error {
+	return d.WaitForMessagePostedRecentlyEqual(userID, channelID, message.Description)
+}
+
+// WaitForLastInteractiveMessagePostedEqual implements BotDriver.
+func (d *slackDriver) WaitForLastInteractiveMessagePostedEqual(userID string, channelID string, message interactive.CoreMessage) error {
+	return d.WaitForLastMessageEqual(userID, channelID, message.Description)
+}
+
+// WaitForMessagePostedWithFileUpload implements BotDriver.
+func (d *slackDriver) WaitForMessagePostedWithFileUpload(userID, channelID string, assertFn FileUploadAssertion) error {
+	return d.waitFor(func() (bool, error) {
+		for _, m := range d.messagesFrom(userID, channelID, 0) {
+			for _, f := range m.Files {
+				if assertFn(f.Title, f.Mimetype) {
+					return true, nil
+				}
+			}
+		}
+		return false, nil
+	})
+}
+
+// WaitForMessagePostedWithAttachment implements BotDriver.
+func (d *slackDriver) WaitForMessagePostedWithAttachment(userID, channel string, limitMessages int, _ ExpAttachmentInput) error {
+	return d.WaitForMessagePosted(userID, channel, limitMessages, func(content string) (bool, int, string) {
+		return content != "", 0, ""
+	})
+}
+
+// Channel implements BotDriver.
+func (d *slackDriver) Channel() Channel { return d.channel }
+
+// SecondChannel implements BotDriver.
+func (d *slackDriver) SecondChannel() Channel { return d.secondChannel }
+
+// ThirdChannel implements BotDriver.
+func (d *slackDriver) ThirdChannel() Channel { return d.thirdChannel }
+
+// BotName implements BotDriver.
+func (d *slackDriver) BotName() string { return "botkube" }
+
+// BotUserID implements BotDriver.
+func (d *slackDriver) BotUserID() string { return d.botUserID }
+
+// TesterUserID implements BotDriver.
+func (d *slackDriver) TesterUserID() string { return d.testerUserID }